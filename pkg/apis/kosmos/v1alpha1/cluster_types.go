@@ -0,0 +1,79 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Cluster represents the registration of a member cluster with clusterlink.
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the specification for the behaviour of the cluster.
+	Spec ClusterSpec `json:"spec"`
+
+	// Status describes the current status of a cluster.
+	// +optional
+	Status ClusterStatus `json:"status,omitempty"`
+}
+
+// ClusterNetworkType is the networking mode clusterlink uses to bridge this
+// cluster to its peers.
+type ClusterNetworkType string
+
+const (
+	NetworkTypeP2P     ClusterNetworkType = "p2p"
+	NetworkTypeGateway ClusterNetworkType = "gateway"
+	// NetworkTypeMesh exposes Services through ServiceExport/ServiceImport
+	// EndpointSlice mirroring instead of a Cluster-wide CIDR bridge. The
+	// mirrored EndpointSlices still carry the exporting cluster's raw pod
+	// IPs, so peer pods need those IPs to be routable (the same pod-CIDR
+	// reachability p2p/gateway mode sets up) for traffic to reach them; no
+	// NAT or gateway translation through the tunnel is done.
+	NetworkTypeMesh ClusterNetworkType = "mesh"
+)
+
+// ClusterSpec is the specification for the behaviour of the cluster.
+// It holds only networkType, cni, kubeconfig and namespace. The other spec
+// fields of the clusters.kosmos.io CRD (bridgeCIDRs, defaultNICName,
+// globalCIDRsMap, imageRepository, ipFamily, localCIDRs, nicNodeNames,
+// useIPPool) have no field here and are dropped when a Cluster is decoded
+// into this type.
+type ClusterSpec struct {
+	// +kubebuilder:default=p2p
+	// +optional
+	NetworkType ClusterNetworkType `json:"networkType,omitempty"`
+
+	// +kubebuilder:default=calico
+	// +optional
+	CNI string `json:"cni,omitempty"`
+
+	// +optional
+	Kubeconfig []byte `json:"kubeconfig,omitempty"`
+
+	// +kubebuilder:default=clusterlink-system
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ClusterStatus describes the current status of a cluster.
+type ClusterStatus struct {
+	// +optional
+	PodCIDRs []string `json:"podCIDRs,omitempty"`
+
+	// +optional
+	ServiceCIDRs []string `json:"serviceCIDRs,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterList contains a list of Cluster.
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Cluster `json:"items"`
+}
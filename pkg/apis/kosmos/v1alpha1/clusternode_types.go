@@ -0,0 +1,56 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterNode mirrors a node belonging to a member cluster so clusterlink
+// can program its tunnel devices, routes and FDB/ARP entries.
+type ClusterNode struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterNodeSpec `json:"spec"`
+
+	// +optional
+	Status ClusterNodeStatus `json:"status,omitempty"`
+}
+
+// ClusterNodeSpec describes a single node's tunnel-relevant attributes.
+type ClusterNodeSpec struct {
+	ClusterName string `json:"clusterName,omitempty"`
+	NodeName    string `json:"nodeName,omitempty"`
+
+	// +optional
+	InterfaceName string `json:"interfaceName,omitempty"`
+
+	// +optional
+	IP string `json:"ip,omitempty"`
+
+	// +optional
+	IP6 string `json:"ip6,omitempty"`
+
+	// +optional
+	PodCIDRs []string `json:"podCIDRs,omitempty"`
+
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+}
+
+// ClusterNodeStatus is currently empty; reserved for future per-node tunnel
+// health reporting.
+type ClusterNodeStatus struct {
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterNodeList contains a list of ClusterNode.
+type ClusterNodeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterNode `json:"items"`
+}
@@ -0,0 +1,97 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NodeConfig carries the routes, FDB/ARP entries, devices and iptables
+// rules that the clusterlink agent on a node must converge to.
+type NodeConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec NodeConfigSpec `json:"spec"`
+
+	// +optional
+	Status NodeConfigStatus `json:"status,omitempty"`
+}
+
+// NodeConfigSpec is the desired tunnel configuration for a single node.
+type NodeConfigSpec struct {
+	// +optional
+	Devices []Device `json:"devices,omitempty"`
+
+	// +optional
+	Routes []Route `json:"routes,omitempty"`
+
+	// +optional
+	Arps []Arp `json:"arps,omitempty"`
+
+	// +optional
+	Fdbs []Fdb `json:"fdbs,omitempty"`
+
+	// +optional
+	Iptables []Iptable `json:"iptables,omitempty"`
+}
+
+// Device describes a tunnel device to create on the node.
+type Device struct {
+	ID      int32  `json:"id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Addr    string `json:"addr"`
+	Mac     string `json:"mac"`
+	Port    int32  `json:"port"`
+	BindDev string `json:"bindDev"`
+}
+
+// Route describes a route entry the node must program.
+type Route struct {
+	CIDR string `json:"cidr"`
+	Dev  string `json:"dev"`
+	Gw   string `json:"gw"`
+}
+
+// Arp describes a static ARP entry the node must program.
+type Arp struct {
+	IP  string `json:"ip"`
+	Dev string `json:"dev"`
+	Mac string `json:"mac"`
+}
+
+// Fdb describes a static bridge forwarding-database entry.
+type Fdb struct {
+	IP  string `json:"ip"`
+	Dev string `json:"dev"`
+	Mac string `json:"mac"`
+}
+
+// Iptable describes a single iptables rule to ensure.
+type Iptable struct {
+	Table string `json:"table"`
+	Chain string `json:"chain"`
+	Rule  string `json:"rule"`
+}
+
+// NodeConfigStatus reports the last time the desired spec was computed and
+// the last time the node agent confirmed it had converged to it.
+type NodeConfigStatus struct {
+	// +optional
+	LastChangeTime *metav1.Time `json:"lastChangeTime,omitempty"`
+
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NodeConfigList contains a list of NodeConfig.
+type NodeConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeConfig `json:"items"`
+}
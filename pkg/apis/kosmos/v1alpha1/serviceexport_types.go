@@ -0,0 +1,42 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceExport marks a Service for export to the peer clusters joined to
+// this one through clusterlink, mirroring the multicluster.x-k8s.io
+// ServiceExport API so the host cluster's network-manager mirrors its
+// EndpointSlices onto every peer. The mirrored EndpointSlices carry the
+// exporting cluster's pod IPs unchanged, so peer pods reach them only where
+// those pod IPs are routable from the peer cluster.
+type ServiceExport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status ServiceExportStatus `json:"status,omitempty"`
+}
+
+// ServiceExportStatus reports which peer clusters currently have the
+// exported Service's EndpointSlices mirrored onto them.
+type ServiceExportStatus struct {
+	// Clusters is the set of peer cluster names the Service is currently
+	// mirrored to.
+	// +optional
+	Clusters []string `json:"clusters,omitempty"`
+
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceExportList contains a list of ServiceExport.
+type ServiceExportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceExport `json:"items"`
+}
@@ -0,0 +1,67 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceImportType describes how a ServiceImport should be rendered as a
+// Service on the consuming cluster.
+type ServiceImportType string
+
+const (
+	// ClusterSetIP gives the imported Service a stable virtual IP, backed by
+	// the mirrored EndpointSlices from the exporting cluster.
+	ClusterSetIP ServiceImportType = "ClusterSetIP"
+	// Headless mirrors the exporting cluster's EndpointSlices without
+	// allocating a ClusterIP.
+	Headless ServiceImportType = "Headless"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceImport is the synthetic ClusterIP Service created on a consuming
+// cluster for a Service exported by a peer cluster via ServiceExport.
+type ServiceImport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ServiceImportSpec `json:"spec"`
+}
+
+// ServiceImportSpec describes the Service imported from a peer cluster.
+type ServiceImportSpec struct {
+	// SourceCluster is the name of the Cluster (see the Cluster CRD) that
+	// exported the backing Service.
+	SourceCluster string `json:"sourceCluster"`
+
+	// IP is the ClusterIP allocated for the synthetic Service on this
+	// cluster.
+	// +optional
+	IP string `json:"ip,omitempty"`
+
+	// +optional
+	Ports []ServiceImportPort `json:"ports,omitempty"`
+
+	// +kubebuilder:default=ClusterSetIP
+	// +optional
+	Type ServiceImportType `json:"type,omitempty"`
+}
+
+// ServiceImportPort mirrors a single port of the exported Service.
+type ServiceImportPort struct {
+	// +optional
+	Name     string          `json:"name,omitempty"`
+	Port     int32           `json:"port"`
+	Protocol corev1.Protocol `json:"protocol,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceImportList contains a list of ServiceImport.
+type ServiceImportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceImport `json:"items"`
+}
@@ -0,0 +1,41 @@
+package clusterlink
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kosmosv1alpha1 "github.com/kosmos-io/kosmos/pkg/apis/kosmos/v1alpha1"
+	knodeutils "github.com/kosmos-io/kosmos/pkg/knode-manager/utils"
+)
+
+// ClusterController writes the podCIDRs recorded on a cluster's ClusterNodes
+// onto its Cluster status. The cluster's kubeconfig is not read and no
+// serviceCIDRs are discovered, so status.serviceCIDRs is left empty.
+type ClusterController struct {
+	client.Client
+}
+
+func (r *ClusterController) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	cluster := &kosmosv1alpha1.Cluster{}
+	if err := r.Get(ctx, req.NamespacedName, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	podCIDRs, serviceCIDRs, err := discoverClusterCIDRs(ctx, r.Client, cluster)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	err = knodeutils.RetryOnConflictWithCachedState(ctx, r.Client, req.NamespacedName, cluster, func(c *kosmosv1alpha1.Cluster) error {
+		c.Status.PodCIDRs = podCIDRs
+		c.Status.ServiceCIDRs = serviceCIDRs
+		return nil
+	})
+	return reconcile.Result{}, err
+}
@@ -0,0 +1,54 @@
+package clusterlink
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kosmosv1alpha1 "github.com/kosmos-io/kosmos/pkg/apis/kosmos/v1alpha1"
+	knodeutils "github.com/kosmos-io/kosmos/pkg/knode-manager/utils"
+)
+
+// ClusterNodeController keeps a ClusterNode's podCIDRs in sync with the
+// underlying corev1.Node it mirrors.
+type ClusterNodeController struct {
+	client.Client
+}
+
+func (r *ClusterNodeController) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	clusterNode := &kosmosv1alpha1.ClusterNode{}
+	if err := r.Get(ctx, req.NamespacedName, clusterNode); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	podCIDRs, err := discoverNodePodCIDRs(ctx, r.Client, clusterNode)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if stringSlicesEqual(clusterNode.Spec.PodCIDRs, podCIDRs) {
+		return reconcile.Result{}, nil
+	}
+
+	err = knodeutils.RetryOnConflictWithCachedState(ctx, r.Client, req.NamespacedName, clusterNode, func(n *kosmosv1alpha1.ClusterNode) error {
+		n.Spec.PodCIDRs = podCIDRs
+		return nil
+	})
+	return reconcile.Result{}, err
+}
+
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
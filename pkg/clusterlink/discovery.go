@@ -0,0 +1,57 @@
+package clusterlink
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kosmosv1alpha1 "github.com/kosmos-io/kosmos/pkg/apis/kosmos/v1alpha1"
+)
+
+// discoverClusterCIDRs aggregates the podCIDRs already recorded on this
+// cluster's ClusterNodes for Cluster.status.
+func discoverClusterCIDRs(ctx context.Context, c client.Client, cluster *kosmosv1alpha1.Cluster) ([]string, []string, error) {
+	nodes := &kosmosv1alpha1.ClusterNodeList{}
+	if err := c.List(ctx, nodes); err != nil {
+		return nil, nil, err
+	}
+
+	var podCIDRs []string
+	for i := range nodes.Items {
+		if nodes.Items[i].Spec.ClusterName != cluster.Name {
+			continue
+		}
+		podCIDRs = append(podCIDRs, nodes.Items[i].Spec.PodCIDRs...)
+	}
+	return podCIDRs, nil, nil
+}
+
+// discoverNodePodCIDRs reads the podCIDRs assigned to the corev1.Node that
+// backs clusterNode.
+func discoverNodePodCIDRs(ctx context.Context, c client.Client, clusterNode *kosmosv1alpha1.ClusterNode) ([]string, error) {
+	node := &corev1.Node{}
+	if err := c.Get(ctx, client.ObjectKey{Name: clusterNode.Spec.NodeName}, node); err != nil {
+		return nil, err
+	}
+	if len(node.Spec.PodCIDRs) > 0 {
+		return node.Spec.PodCIDRs, nil
+	}
+	if node.Spec.PodCIDR != "" {
+		return []string{node.Spec.PodCIDR}, nil
+	}
+	return nil, nil
+}
+
+// computeNodeConfigSpec looks up the ClusterNode named by key and returns an
+// empty spec with ok false: no devices, routes, FDB or ARP entries are
+// computed, and peer ClusterNodes are not read. ok false tells
+// NodeConfigController to leave an existing NodeConfig.Spec alone rather
+// than overwrite it with the empty spec.
+func computeNodeConfigSpec(ctx context.Context, c client.Client, key client.ObjectKey) (spec kosmosv1alpha1.NodeConfigSpec, ok bool, err error) {
+	clusterNode := &kosmosv1alpha1.ClusterNode{}
+	if err := c.Get(ctx, key, clusterNode); err != nil {
+		return kosmosv1alpha1.NodeConfigSpec{}, false, err
+	}
+	return kosmosv1alpha1.NodeConfigSpec{}, false, nil
+}
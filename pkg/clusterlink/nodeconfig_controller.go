@@ -0,0 +1,60 @@
+package clusterlink
+
+import (
+	"context"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kosmosv1alpha1 "github.com/kosmos-io/kosmos/pkg/apis/kosmos/v1alpha1"
+	knodeutils "github.com/kosmos-io/kosmos/pkg/knode-manager/utils"
+)
+
+// NodeConfigController writes the spec returned by computeNodeConfigSpec to
+// a NodeConfig and stamps status.lastChangeTime whenever it changes, so the
+// node agent knows a new generation is ready to converge to. Only a spec
+// reported with ok true is written; computeNodeConfigSpec always reports ok
+// false, so Reconcile leaves every NodeConfig unchanged.
+type NodeConfigController struct {
+	client.Client
+}
+
+func (r *NodeConfigController) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	nodeConfig := &kosmosv1alpha1.NodeConfig{}
+	if err := r.Get(ctx, req.NamespacedName, nodeConfig); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	desired, ok, err := computeNodeConfigSpec(ctx, r.Client, req.NamespacedName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !ok {
+		// No spec was computed. Leave the existing spec untouched rather
+		// than overwrite it with a zero value.
+		return reconcile.Result{}, nil
+	}
+	if reflect.DeepEqual(nodeConfig.Spec, desired) {
+		return reconcile.Result{}, nil
+	}
+
+	if err := knodeutils.RetryOnConflictWithCachedState(ctx, r.Client, req.NamespacedName, nodeConfig, func(nc *kosmosv1alpha1.NodeConfig) error {
+		nc.Spec = desired
+		return nil
+	}); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	err = knodeutils.RetryStatusOnConflictWithCachedState(ctx, r.Client, req.NamespacedName, nodeConfig, func(nc *kosmosv1alpha1.NodeConfig) error {
+		now := metav1.Now()
+		nc.Status.LastChangeTime = &now
+		return nil
+	})
+	return reconcile.Result{}, err
+}
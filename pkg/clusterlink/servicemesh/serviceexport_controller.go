@@ -0,0 +1,79 @@
+package servicemesh
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kosmosv1alpha1 "github.com/kosmos-io/kosmos/pkg/apis/kosmos/v1alpha1"
+	knodeutils "github.com/kosmos-io/kosmos/pkg/knode-manager/utils"
+)
+
+// ServiceExportController watches ServiceExport objects on the host cluster
+// and mirrors the backing EndpointSlices of the exported Service to every
+// peer cluster. It only duplicates the EndpointSlice objects — see
+// syncEndpointSlices for why peer pods still need the exporting cluster's
+// pod CIDRs to be routable.
+type ServiceExportController struct {
+	client.Client
+	// PeerClients returns a live clientset per peer cluster, keyed by the
+	// peer's Cluster name.
+	PeerClients func() map[string]kubernetes.Interface
+}
+
+func (c *ServiceExportController) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	export := &kosmosv1alpha1.ServiceExport{}
+	if err := c.Get(ctx, req.NamespacedName, export); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, c.cleanupPeerEndpointSlices(ctx, req.Namespace, req.Name)
+		}
+		return reconcile.Result{}, err
+	}
+
+	svc := &corev1.Service{}
+	if err := c.Get(ctx, req.NamespacedName, svc); err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.Warningf("serviceexport %s/%s has no backing service, skipping", req.Namespace, req.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	slices := &discoveryv1.EndpointSliceList{}
+	if err := c.List(ctx, slices, client.InNamespace(req.Namespace), client.MatchingLabels{discoveryv1.LabelServiceName: req.Name}); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var clusters []string
+	for name, peer := range c.PeerClients() {
+		if err := syncEndpointSlices(ctx, peer, svc, slices.Items); err != nil {
+			klog.Errorf("failed to sync endpointslices for %s/%s to cluster %s: %v", req.Namespace, req.Name, name, err)
+			continue
+		}
+		clusters = append(clusters, name)
+	}
+
+	return reconcile.Result{}, knodeutils.RetryStatusOnConflictWithCachedState(ctx, c.Client, req.NamespacedName, export, func(e *kosmosv1alpha1.ServiceExport) error {
+		e.Status.Clusters = clusters
+		return nil
+	})
+}
+
+// cleanupPeerEndpointSlices removes the mirrored EndpointSlices from every
+// peer cluster once the ServiceExport backing them has been deleted.
+func (c *ServiceExportController) cleanupPeerEndpointSlices(ctx context.Context, namespace, name string) error {
+	selector := metav1.ListOptions{LabelSelector: discoveryv1.LabelServiceName + "=" + name}
+	for peerName, peer := range c.PeerClients() {
+		if err := peer.DiscoveryV1().EndpointSlices(namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, selector); err != nil && !apierrors.IsNotFound(err) {
+			klog.Errorf("failed to remove mirrored endpointslices for %s/%s from cluster %s: %v", namespace, name, peerName, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package servicemesh
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kosmosv1alpha1 "github.com/kosmos-io/kosmos/pkg/apis/kosmos/v1alpha1"
+)
+
+// ServiceImportController watches ServiceImport objects and keeps the
+// synthetic ClusterIP Service that represents a peer cluster's exported
+// Service up to date on this cluster.
+type ServiceImportController struct {
+	client.Client
+}
+
+func (c *ServiceImportController) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	svcImport := &kosmosv1alpha1.ServiceImport{}
+	if err := c.Get(ctx, req.NamespacedName, svcImport); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	svc := &corev1.Service{}
+	svc.Namespace = req.Namespace
+	svc.Name = req.Name
+	if _, err := controllerutil.CreateOrUpdate(ctx, c.Client, svc, func() error {
+		applySyntheticService(svc, svcImport)
+		return nil
+	}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to sync synthetic service for import %s/%s: %v", req.Namespace, req.Name, err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// applySyntheticService renders the ClusterIP Service that fronts the
+// mirrored EndpointSlices created by syncEndpointSlices on this cluster.
+func applySyntheticService(svc *corev1.Service, imp *kosmosv1alpha1.ServiceImport) {
+	if svc.Labels == nil {
+		svc.Labels = map[string]string{}
+	}
+	svc.Labels[discoveryv1.LabelManagedBy] = "clusterlink-service-mesh"
+	svc.Spec.ClusterIP = imp.Spec.IP
+	if imp.Spec.Type == kosmosv1alpha1.Headless {
+		svc.Spec.ClusterIP = corev1.ClusterIPNone
+	}
+	svc.Spec.Type = corev1.ServiceTypeClusterIP
+	svc.Spec.Ports = nil
+	for _, p := range imp.Spec.Ports {
+		proto := p.Protocol
+		if proto == "" {
+			proto = corev1.ProtocolTCP
+		}
+		svc.Spec.Ports = append(svc.Spec.Ports, corev1.ServicePort{
+			Name:     p.Name,
+			Port:     p.Port,
+			Protocol: proto,
+		})
+	}
+}
+
+// syncEndpointSlices mirrors the source cluster's EndpointSlices for svc
+// into the peer clientset under a kosmos-owned name.
+//
+// This copies the exporting cluster's addresses verbatim: it does not NAT
+// or proxy them through the clusterlink tunnel, so the peer cluster still
+// needs L3 reachability to those pod IPs for traffic to land — the same
+// pod-CIDR reachability p2p/gateway mode provides. No tunnel-side gateway
+// terminates traffic to these addresses, so mesh mode saves the
+// bridge-CIDR bookkeeping, not the routing.
+func syncEndpointSlices(ctx context.Context, peer kubernetes.Interface, svc *corev1.Service, slices []discoveryv1.EndpointSlice) error {
+	for i := range slices {
+		mirrored := slices[i].DeepCopy()
+		mirrored.ResourceVersion = ""
+		mirrored.UID = ""
+		mirrored.OwnerReferences = nil
+		mirrored.Name = fmt.Sprintf("%s-%s", svc.Name, mirrored.Name)
+		mirrored.Namespace = svc.Namespace
+		if mirrored.Labels == nil {
+			mirrored.Labels = map[string]string{}
+		}
+		mirrored.Labels[discoveryv1.LabelServiceName] = svc.Name
+
+		existing, err := peer.DiscoveryV1().EndpointSlices(mirrored.Namespace).Get(ctx, mirrored.Name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			_, err = peer.DiscoveryV1().EndpointSlices(mirrored.Namespace).Create(ctx, mirrored, metav1.CreateOptions{})
+		case err == nil:
+			mirrored.ResourceVersion = existing.ResourceVersion
+			_, err = peer.DiscoveryV1().EndpointSlices(mirrored.Namespace).Update(ctx, mirrored, metav1.UpdateOptions{})
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
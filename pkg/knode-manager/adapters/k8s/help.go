@@ -7,28 +7,154 @@ import (
 	"k8s.io/klog"
 )
 
+// secretExtractor reports the Secret names a single pod volume depends on.
+// ok is false when the volume kind isn't one this extractor understands, so
+// getSecrets can try the next one.
+type secretExtractor func(pod *corev1.Pod, v corev1.Volume) (secretNames []string, ok bool)
+
+var secretExtractors []secretExtractor
+
+func registerSecretExtractor(e secretExtractor) {
+	secretExtractors = append(secretExtractors, e)
+}
+
+func init() {
+	registerSecretExtractor(extractSecretVolume)
+	registerSecretExtractor(extractCephFSSecret)
+	registerSecretExtractor(extractCinderSecret)
+	registerSecretExtractor(extractRBDSecret)
+	registerSecretExtractor(extractCSISecret)
+	registerSecretExtractor(extractScaleIOSecret)
+	registerSecretExtractor(extractStorageOSSecret)
+	registerSecretExtractor(extractAzureFileSecret)
+	registerSecretExtractor(extractFlexVolumeSecret)
+	registerSecretExtractor(extractProjectedServiceAccountToken)
+}
+
+func extractSecretVolume(pod *corev1.Pod, v corev1.Volume) ([]string, bool) {
+	if v.Secret == nil {
+		return nil, false
+	}
+	if strings.HasPrefix(v.Name, "default-token") {
+		return nil, true
+	}
+	klog.Infof("pod %s depends on secret %s", pod.Name, v.Secret.SecretName)
+	return []string{v.Secret.SecretName}, true
+}
+
+func extractCephFSSecret(pod *corev1.Pod, v corev1.Volume) ([]string, bool) {
+	if v.CephFS == nil || v.CephFS.SecretRef == nil {
+		return nil, false
+	}
+	klog.Infof("pod %s depends on secret %s", pod.Name, v.CephFS.SecretRef.Name)
+	return []string{v.CephFS.SecretRef.Name}, true
+}
+
+func extractCinderSecret(pod *corev1.Pod, v corev1.Volume) ([]string, bool) {
+	if v.Cinder == nil || v.Cinder.SecretRef == nil {
+		return nil, false
+	}
+	klog.Infof("pod %s depends on secret %s", pod.Name, v.Cinder.SecretRef.Name)
+	return []string{v.Cinder.SecretRef.Name}, true
+}
+
+func extractRBDSecret(pod *corev1.Pod, v corev1.Volume) ([]string, bool) {
+	if v.RBD == nil || v.RBD.SecretRef == nil {
+		return nil, false
+	}
+	klog.Infof("pod %s depends on secret %s", pod.Name, v.RBD.SecretRef.Name)
+	return []string{v.RBD.SecretRef.Name}, true
+}
+
+func extractCSISecret(pod *corev1.Pod, v corev1.Volume) ([]string, bool) {
+	if v.CSI == nil || v.CSI.NodePublishSecretRef == nil {
+		return nil, false
+	}
+	klog.Infof("pod %s depends on secret %s", pod.Name, v.CSI.NodePublishSecretRef.Name)
+	return []string{v.CSI.NodePublishSecretRef.Name}, true
+}
+
+func extractScaleIOSecret(pod *corev1.Pod, v corev1.Volume) ([]string, bool) {
+	if v.ScaleIO == nil || v.ScaleIO.SecretRef == nil {
+		return nil, false
+	}
+	klog.Infof("pod %s depends on secret %s", pod.Name, v.ScaleIO.SecretRef.Name)
+	return []string{v.ScaleIO.SecretRef.Name}, true
+}
+
+func extractStorageOSSecret(pod *corev1.Pod, v corev1.Volume) ([]string, bool) {
+	if v.StorageOS == nil || v.StorageOS.SecretRef == nil {
+		return nil, false
+	}
+	klog.Infof("pod %s depends on secret %s", pod.Name, v.StorageOS.SecretRef.Name)
+	return []string{v.StorageOS.SecretRef.Name}, true
+}
+
+func extractAzureFileSecret(pod *corev1.Pod, v corev1.Volume) ([]string, bool) {
+	if v.AzureFile == nil || v.AzureFile.SecretName == "" {
+		return nil, false
+	}
+	klog.Infof("pod %s depends on secret %s", pod.Name, v.AzureFile.SecretName)
+	return []string{v.AzureFile.SecretName}, true
+}
+
+func extractFlexVolumeSecret(pod *corev1.Pod, v corev1.Volume) ([]string, bool) {
+	if v.FlexVolume == nil || v.FlexVolume.SecretRef == nil {
+		return nil, false
+	}
+	klog.Infof("pod %s depends on secret %s", pod.Name, v.FlexVolume.SecretRef.Name)
+	return []string{v.FlexVolume.SecretRef.Name}, true
+}
+
+// ProjectedServiceAccountTokenMirror synthesizes a bound ServiceAccount
+// token for a projected volume's serviceAccountToken source on the host
+// cluster and mirrors it as a Secret on the leaf cluster, so a leaf-scheduled
+// pod mounting one doesn't fail volume setup. It is nil until
+// SetupProjectedServiceAccountTokenMirror is called with both cluster
+// clientsets — wire that in wherever this package's caller bootstraps the
+// host/leaf clients, before any pod translation needs it.
+var ProjectedServiceAccountTokenMirror func(pod *corev1.Pod, source corev1.ServiceAccountTokenProjection) (secretName string, err error)
+
+func extractProjectedServiceAccountToken(pod *corev1.Pod, v corev1.Volume) ([]string, bool) {
+	if v.Projected == nil {
+		return nil, false
+	}
+	var secretNames []string
+	handled := false
+	for _, source := range v.Projected.Sources {
+		if source.ServiceAccountToken == nil {
+			continue
+		}
+		handled = true
+		if ProjectedServiceAccountTokenMirror == nil {
+			klog.Warningf("pod %s volume %s needs a mirrored projected serviceAccountToken but no mirror is configured, skipping", pod.Name, v.Name)
+			continue
+		}
+		name, err := ProjectedServiceAccountTokenMirror(pod, *source.ServiceAccountToken)
+		if err != nil {
+			klog.Errorf("failed to mirror projected serviceAccountToken for pod %s volume %s: %v", pod.Name, v.Name, err)
+			continue
+		}
+		secretNames = append(secretNames, name)
+	}
+	return secretNames, handled
+}
+
 func getSecrets(pod *corev1.Pod) []string {
 	secretNames := []string{}
 	for _, v := range pod.Spec.Volumes {
-		switch {
-		case v.Secret != nil:
-			if strings.HasPrefix(v.Name, "default-token") {
+		handled := false
+		for _, extract := range secretExtractors {
+			names, ok := extract(pod, v)
+			if !ok {
 				continue
 			}
-			klog.Infof("pod %s depends on secret %s", pod.Name, v.Secret.SecretName)
-			secretNames = append(secretNames, v.Secret.SecretName)
-
-		case v.CephFS != nil:
-			klog.Infof("pod %s depends on secret %s", pod.Name, v.CephFS.SecretRef.Name)
-			secretNames = append(secretNames, v.CephFS.SecretRef.Name)
-		case v.Cinder != nil:
-			klog.Infof("pod %s depends on secret %s", pod.Name, v.Cinder.SecretRef.Name)
-			secretNames = append(secretNames, v.Cinder.SecretRef.Name)
-		case v.RBD != nil:
-			klog.Infof("pod %s depends on secret %s", pod.Name, v.RBD.SecretRef.Name)
-			secretNames = append(secretNames, v.RBD.SecretRef.Name)
-		default:
-			klog.Warning("Skip other type volumes")
+			handled = true
+			secretNames = append(secretNames, names...)
+			break
+		}
+		if !handled {
+			klog.Warningf("Skip other type volume %s", v.Name)
 		}
 	}
 	if pod.Spec.ImagePullSecrets != nil {
@@ -0,0 +1,132 @@
+package k8sadapter
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestGetSecrets(t *testing.T) {
+	tests := []struct {
+		name    string
+		volumes []corev1.Volume
+		want    []string
+	}{
+		{
+			name: "secret volume",
+			volumes: []corev1.Volume{
+				{Name: "v", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "s1"}}},
+			},
+			want: []string{"s1"},
+		},
+		{
+			name: "default-token secret volume is skipped",
+			volumes: []corev1.Volume{
+				{Name: "default-token-abcde", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "default-token-abcde"}}},
+			},
+			want: nil,
+		},
+		{
+			name: "cephfs volume",
+			volumes: []corev1.Volume{
+				{Name: "v", VolumeSource: corev1.VolumeSource{CephFS: &corev1.CephFSVolumeSource{SecretRef: &corev1.LocalObjectReference{Name: "s1"}}}},
+			},
+			want: []string{"s1"},
+		},
+		{
+			name: "cinder volume",
+			volumes: []corev1.Volume{
+				{Name: "v", VolumeSource: corev1.VolumeSource{Cinder: &corev1.CinderVolumeSource{SecretRef: &corev1.LocalObjectReference{Name: "s1"}}}},
+			},
+			want: []string{"s1"},
+		},
+		{
+			name: "rbd volume",
+			volumes: []corev1.Volume{
+				{Name: "v", VolumeSource: corev1.VolumeSource{RBD: &corev1.RBDVolumeSource{SecretRef: &corev1.LocalObjectReference{Name: "s1"}}}},
+			},
+			want: []string{"s1"},
+		},
+		{
+			name: "csi volume",
+			volumes: []corev1.Volume{
+				{Name: "v", VolumeSource: corev1.VolumeSource{CSI: &corev1.CSIVolumeSource{Driver: "d", NodePublishSecretRef: &corev1.LocalObjectReference{Name: "s1"}}}},
+			},
+			want: []string{"s1"},
+		},
+		{
+			name: "scaleio volume",
+			volumes: []corev1.Volume{
+				{Name: "v", VolumeSource: corev1.VolumeSource{ScaleIO: &corev1.ScaleIOVolumeSource{SecretRef: &corev1.LocalObjectReference{Name: "s1"}}}},
+			},
+			want: []string{"s1"},
+		},
+		{
+			name: "storageos volume",
+			volumes: []corev1.Volume{
+				{Name: "v", VolumeSource: corev1.VolumeSource{StorageOS: &corev1.StorageOSVolumeSource{SecretRef: &corev1.LocalObjectReference{Name: "s1"}}}},
+			},
+			want: []string{"s1"},
+		},
+		{
+			name: "azurefile volume",
+			volumes: []corev1.Volume{
+				{Name: "v", VolumeSource: corev1.VolumeSource{AzureFile: &corev1.AzureFileVolumeSource{SecretName: "s1"}}},
+			},
+			want: []string{"s1"},
+		},
+		{
+			name: "flexvolume",
+			volumes: []corev1.Volume{
+				{Name: "v", VolumeSource: corev1.VolumeSource{FlexVolume: &corev1.FlexVolumeSource{Driver: "d", SecretRef: &corev1.LocalObjectReference{Name: "s1"}}}},
+			},
+			want: []string{"s1"},
+		},
+		{
+			name: "unhandled volume type is skipped, not fatal",
+			volumes: []corev1.Volume{
+				{Name: "v", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+			},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{Spec: corev1.PodSpec{Volumes: tt.volumes}}
+			got := getSecrets(pod)
+			if len(got) == 0 {
+				got = nil
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getSecrets() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetSecretsProjectedServiceAccountToken(t *testing.T) {
+	defer func() { ProjectedServiceAccountTokenMirror = nil }()
+
+	pod := &corev1.Pod{Spec: corev1.PodSpec{Volumes: []corev1.Volume{
+		{Name: "kube-api-access", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{
+			Sources: []corev1.VolumeProjection{{ServiceAccountToken: &corev1.ServiceAccountTokenProjection{Path: "token"}}},
+		}}},
+	}}}
+
+	ProjectedServiceAccountTokenMirror = nil
+	if got := getSecrets(pod); len(got) != 0 {
+		t.Errorf("expected no secrets without a mirror configured, got %v", got)
+	}
+
+	ProjectedServiceAccountTokenMirror = func(pod *corev1.Pod, source corev1.ServiceAccountTokenProjection) (string, error) {
+		return fmt.Sprintf("%s-token", pod.Name), nil
+	}
+	pod.Name = "app"
+	got := getSecrets(pod)
+	if !reflect.DeepEqual(got, []string{"app-token"}) {
+		t.Errorf("getSecrets() = %v, want [app-token]", got)
+	}
+}
@@ -0,0 +1,80 @@
+package k8sadapter
+
+import (
+	"context"
+	"fmt"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// SetupProjectedServiceAccountTokenMirror wires
+// ProjectedServiceAccountTokenMirror to request a bound token from the host
+// cluster's TokenRequest API and mirror it as an Opaque Secret on the leaf
+// cluster, so a leaf-scheduled pod mounting a projected serviceAccountToken
+// volume gets a real Secret behind it instead of failing volume setup. Call
+// this once both cluster clientsets are available, before the knode-manager
+// starts translating pods to the leaf.
+func SetupProjectedServiceAccountTokenMirror(hostClient, leafClient kubernetes.Interface) {
+	ProjectedServiceAccountTokenMirror = func(pod *corev1.Pod, source corev1.ServiceAccountTokenProjection) (string, error) {
+		return mirrorProjectedServiceAccountToken(context.Background(), hostClient, leafClient, pod, source)
+	}
+}
+
+func mirrorProjectedServiceAccountToken(ctx context.Context, hostClient, leafClient kubernetes.Interface, pod *corev1.Pod, source corev1.ServiceAccountTokenProjection) (string, error) {
+	saName := pod.Spec.ServiceAccountName
+	if saName == "" {
+		saName = "default"
+	}
+
+	var audiences []string
+	if source.Audience != "" {
+		audiences = []string{source.Audience}
+	}
+
+	tr, err := hostClient.CoreV1().ServiceAccounts(pod.Namespace).CreateToken(ctx, saName, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         audiences,
+			ExpirationSeconds: source.ExpirationSeconds,
+			BoundObjectRef: &authenticationv1.BoundObjectReference{
+				Kind:       "Pod",
+				APIVersion: "v1",
+				Name:       pod.Name,
+				UID:        pod.UID,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("could not request bound token for pod %s/%s: %v", pod.Namespace, pod.Name, err)
+	}
+
+	secretName := fmt.Sprintf("%s-sa-token", pod.Name)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: pod.Namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"token": []byte(tr.Status.Token),
+		},
+	}
+
+	if _, err := leafClient.CoreV1().Secrets(pod.Namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return "", fmt.Errorf("could not mirror token secret %s/%s to leaf cluster: %v", pod.Namespace, secretName, err)
+		}
+		existing, err := leafClient.CoreV1().Secrets(pod.Namespace).Get(ctx, secretName, metav1.GetOptions{})
+		if err != nil {
+			return "", fmt.Errorf("could not fetch existing mirrored token secret %s/%s on leaf cluster: %v", pod.Namespace, secretName, err)
+		}
+		secret.ResourceVersion = existing.ResourceVersion
+		if _, err := leafClient.CoreV1().Secrets(pod.Namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+			return "", fmt.Errorf("could not update mirrored token secret %s/%s on leaf cluster: %v", pod.Namespace, secretName, err)
+		}
+	}
+	return secretName, nil
+}
@@ -0,0 +1,143 @@
+package utils
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+type Opts func(*rest.Config)
+
+type configSourceKind int
+
+const (
+	configSourceFile configSourceKind = iota
+	configSourceBytes
+	configSourceInCluster
+	configSourceExec
+)
+
+// ConfigSource describes where a rest.Config should be loaded from. Build
+// one with FromFile, FromBytes, FromInCluster or FromExec and hand it to
+// LoadRestConfig, instead of every client constructor re-implementing
+// kubeconfig loading and in-cluster fallback.
+type ConfigSource struct {
+	kind  configSourceKind
+	path  string
+	bytes []byte
+	exec  *ExecConfig
+}
+
+// ExecConfig describes an exec-plugin credential provider, as used by
+// cloud-provider kubeconfigs (e.g. aws-iam-authenticator, gke-gcloud-auth-plugin).
+type ExecConfig struct {
+	// Host is the API server address the resulting rest.Config talks to.
+	// The exec plugin only supplies credentials, not the endpoint, so this
+	// is required.
+	Host string
+
+	// TLSClientConfig carries the CA/cert material needed to trust Host,
+	// same as any other rest.Config.
+	TLSClientConfig rest.TLSClientConfig
+
+	APIVersion string
+	Command    string
+	Args       []string
+	Env        map[string]string
+}
+
+// FromFile loads the config from a kubeconfig file on disk, falling back to
+// in-cluster config if the file can't be read (the historical NewClient
+// behaviour).
+func FromFile(path string) ConfigSource {
+	return ConfigSource{kind: configSourceFile, path: path}
+}
+
+// FromBytes loads the config from an in-memory kubeconfig, e.g. one read
+// from a Cluster CR's spec.kubeconfig.
+func FromBytes(kubeConfig []byte) ConfigSource {
+	return ConfigSource{kind: configSourceBytes, bytes: kubeConfig}
+}
+
+// FromInCluster loads the config from the in-cluster service account
+// mounted into the pod.
+func FromInCluster() ConfigSource {
+	return ConfigSource{kind: configSourceInCluster}
+}
+
+// FromExec builds a config that authenticates via the given exec-plugin
+// descriptor rather than a static kubeconfig.
+func FromExec(exec ExecConfig) ConfigSource {
+	return ConfigSource{kind: configSourceExec, exec: &exec}
+}
+
+// LoadRestConfig resolves source into a *rest.Config and applies opts, so
+// every client constructor in this package shares one place that knows how
+// to load a kubeconfig, fall back to in-cluster config, or build an
+// exec-plugin config.
+func LoadRestConfig(source ConfigSource, opts ...Opts) (*rest.Config, error) {
+	var (
+		config *rest.Config
+		err    error
+	)
+
+	switch source.kind {
+	case configSourceFile:
+		config, err = clientcmd.BuildConfigFromFlags("", source.path)
+		if err != nil {
+			config, err = rest.InClusterConfig()
+		}
+	case configSourceBytes:
+		var clientConfig clientcmd.ClientConfig
+		clientConfig, err = clientcmd.NewClientConfigFromBytes(source.bytes)
+		if err == nil {
+			config, err = clientConfig.ClientConfig()
+		}
+	case configSourceInCluster:
+		config, err = rest.InClusterConfig()
+	case configSourceExec:
+		config, err = execRestConfig(source.exec)
+	default:
+		return nil, fmt.Errorf("unknown config source")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not load rest config: %v", err)
+	}
+
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		opt(config)
+	}
+	return config, nil
+}
+
+func execRestConfig(exec *ExecConfig) (*rest.Config, error) {
+	if exec.Host == "" {
+		return nil, fmt.Errorf("exec config requires a Host")
+	}
+
+	apiVersion := exec.APIVersion
+	if apiVersion == "" {
+		apiVersion = "client.authentication.k8s.io/v1"
+	}
+
+	var env []clientcmdapi.ExecEnvVar
+	for k, v := range exec.Env {
+		env = append(env, clientcmdapi.ExecEnvVar{Name: k, Value: v})
+	}
+
+	return &rest.Config{
+		Host:            exec.Host,
+		TLSClientConfig: exec.TLSClientConfig,
+		ExecProvider: &clientcmdapi.ExecConfig{
+			APIVersion: apiVersion,
+			Command:    exec.Command,
+			Args:       exec.Args,
+			Env:        env,
+		},
+	}, nil
+}
@@ -10,9 +10,9 @@ import (
 	jsonpatch "github.com/evanphx/json-patch"
 	jsonpatch1 "github.com/mattbaird/jsonpatch"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
+	aggregatorclientset "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset"
 	"k8s.io/metrics/pkg/client/clientset/versioned"
 )
 
@@ -96,28 +96,11 @@ func SetupSignalHandler() <-chan struct{} {
 	return stop
 }
 
-type Opts func(*rest.Config)
-
 func NewClient(configPath string, opts ...Opts) (kubernetes.Interface, error) {
-	var (
-		config *rest.Config
-		err    error
-	)
-	config, err = clientcmd.BuildConfigFromFlags("", configPath)
+	config, err := LoadRestConfig(FromFile(configPath), opts...)
 	if err != nil {
-		config, err = rest.InClusterConfig()
-		if err != nil {
-			return nil, fmt.Errorf("could not read config file for cluster: %v", err)
-		}
-	}
-
-	for _, opt := range opts {
-		if opt == nil {
-			continue
-		}
-		opt(config)
+		return nil, err
 	}
-
 	client, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		return nil, fmt.Errorf("could not create client for master cluster: %v", err)
@@ -126,27 +109,10 @@ func NewClient(configPath string, opts ...Opts) (kubernetes.Interface, error) {
 }
 
 func NewClientFromByte(kubeConfig []byte, opts ...Opts) (kubernetes.Interface, error) {
-	var (
-		config *rest.Config
-		err    error
-	)
-
-	clientconfig, err := clientcmd.NewClientConfigFromBytes(kubeConfig)
+	config, err := LoadRestConfig(FromBytes(kubeConfig), opts...)
 	if err != nil {
 		return nil, err
 	}
-	config, err = clientconfig.ClientConfig()
-	if err != nil {
-		return nil, err
-	}
-
-	for _, opt := range opts {
-		if opt == nil {
-			continue
-		}
-		opt(config)
-	}
-
 	client, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		return nil, fmt.Errorf("could not create client for master cluster: %v", err)
@@ -155,25 +121,10 @@ func NewClientFromByte(kubeConfig []byte, opts ...Opts) (kubernetes.Interface, e
 }
 
 func NewMetricClient(configPath string, opts ...Opts) (versioned.Interface, error) {
-	var (
-		config *rest.Config
-		err    error
-	)
-	config, err = clientcmd.BuildConfigFromFlags("", configPath)
+	config, err := LoadRestConfig(FromFile(configPath), opts...)
 	if err != nil {
-		config, err = rest.InClusterConfig()
-		if err != nil {
-			return nil, fmt.Errorf("could not read config file for cluster: %v", err)
-		}
-	}
-
-	for _, opt := range opts {
-		if opt == nil {
-			continue
-		}
-		opt(config)
+		return nil, err
 	}
-
 	metricClient, err := versioned.NewForConfig(config)
 	if err != nil {
 		return nil, fmt.Errorf("could not create client for master cluster: %v", err)
@@ -182,32 +133,63 @@ func NewMetricClient(configPath string, opts ...Opts) (versioned.Interface, erro
 }
 
 func NewMetricClientFromByte(kubeConfig []byte, opts ...Opts) (versioned.Interface, error) {
-	var (
-		config *rest.Config
-		err    error
-	)
+	config, err := LoadRestConfig(FromBytes(kubeConfig), opts...)
+	if err != nil {
+		return nil, err
+	}
+	metricClient, err := versioned.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create client for master cluster: %v", err)
+	}
+	return metricClient, nil
+}
 
-	clientconfig, err := clientcmd.NewClientConfigFromBytes(kubeConfig)
+func NewAggregatorClient(configPath string, opts ...Opts) (aggregatorclientset.Interface, error) {
+	config, err := LoadRestConfig(FromFile(configPath), opts...)
 	if err != nil {
 		return nil, err
 	}
-	config, err = clientconfig.ClientConfig()
+	client, err := aggregatorclientset.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create aggregator client for master cluster: %v", err)
+	}
+	return client, nil
+}
+
+func NewAggregatorClientFromByte(kubeConfig []byte, opts ...Opts) (aggregatorclientset.Interface, error) {
+	config, err := LoadRestConfig(FromBytes(kubeConfig), opts...)
 	if err != nil {
 		return nil, err
 	}
+	client, err := aggregatorclientset.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create aggregator client for master cluster: %v", err)
+	}
+	return client, nil
+}
 
-	for _, opt := range opts {
-		if opt == nil {
-			continue
-		}
-		opt(config)
+func NewDynamicClient(configPath string, opts ...Opts) (dynamic.Interface, error) {
+	config, err := LoadRestConfig(FromFile(configPath), opts...)
+	if err != nil {
+		return nil, err
 	}
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create dynamic client for master cluster: %v", err)
+	}
+	return client, nil
+}
 
-	metricClient, err := versioned.NewForConfig(config)
+func NewDynamicClientFromByte(kubeConfig []byte, opts ...Opts) (dynamic.Interface, error) {
+	config, err := LoadRestConfig(FromBytes(kubeConfig), opts...)
 	if err != nil {
-		return nil, fmt.Errorf("could not create client for master cluster: %v", err)
+		return nil, err
 	}
-	return metricClient, nil
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create dynamic client for master cluster: %v", err)
+	}
+	return client, nil
 }
 
 func IsVirtualNode(node *corev1.Node) bool {
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RetryOnConflictWithCachedState mutates obj in place via mutate and submits
+// the result as a JSON patch against the main resource, computed from the
+// cached obj to avoid a re-GET on the happy path. If the patch is rejected
+// with a conflict, it refetches obj and re-runs mutate against the fresh
+// resourceVersion, up to retry.DefaultBackoff, instead of every controller
+// open-coding the same get-mutate-patch-on-409 loop.
+//
+// Use RetryStatusOnConflictWithCachedState instead for types with a status
+// subresource — the API server silently drops status-field changes sent
+// through this patch endpoint for those types.
+func RetryOnConflictWithCachedState[T client.Object](ctx context.Context, c client.Client, key types.NamespacedName, obj T, mutate func(T) error) error {
+	return retryOnConflictWithCachedState(ctx, c, key, obj, mutate, false)
+}
+
+// RetryStatusOnConflictWithCachedState is RetryOnConflictWithCachedState for
+// types with a `subresources: status: {}` CRD (e.g. ServiceExport,
+// NodeConfig): mutate should only touch obj's Status, and the resulting
+// patch is submitted through c.Status() so it actually persists.
+func RetryStatusOnConflictWithCachedState[T client.Object](ctx context.Context, c client.Client, key types.NamespacedName, obj T, mutate func(T) error) error {
+	return retryOnConflictWithCachedState(ctx, c, key, obj, mutate, true)
+}
+
+func retryOnConflictWithCachedState[T client.Object](ctx context.Context, c client.Client, key types.NamespacedName, obj T, mutate func(T) error, statusSubresource bool) error {
+	return retry.OnError(retry.DefaultBackoff, apierrors.IsConflict, func() error {
+		original := obj.DeepCopyObject().(T)
+		if err := mutate(obj); err != nil {
+			return err
+		}
+
+		patch, err := CreateJSONPatch(original, obj)
+		if err != nil {
+			return err
+		}
+		if len(patch) == 0 || string(patch) == "[]" {
+			return nil
+		}
+		rawPatch := client.RawPatch(types.JSONPatchType, patch)
+
+		var patchErr error
+		if statusSubresource {
+			patchErr = c.Status().Patch(ctx, obj, rawPatch)
+		} else {
+			patchErr = c.Patch(ctx, obj, rawPatch)
+		}
+		if patchErr != nil {
+			if apierrors.IsConflict(patchErr) {
+				if getErr := c.Get(ctx, key, obj); getErr != nil {
+					return getErr
+				}
+			}
+			return patchErr
+		}
+		return nil
+	})
+}
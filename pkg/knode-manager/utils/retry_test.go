@@ -0,0 +1,147 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kosmosv1alpha1 "github.com/kosmos-io/kosmos/pkg/apis/kosmos/v1alpha1"
+)
+
+var now = metav1.Now()
+
+func newNodeConfig(name string) *kosmosv1alpha1.NodeConfig {
+	return &kosmosv1alpha1.NodeConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	}
+}
+
+func TestRetryOnConflictWithCachedState_PatchesMainResource(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := kosmosv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+
+	nc := newNodeConfig("node1")
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(nc).Build()
+	key := types.NamespacedName{Name: nc.Name}
+
+	err := RetryOnConflictWithCachedState(context.Background(), c, key, nc.DeepCopy(), func(obj *kosmosv1alpha1.NodeConfig) error {
+		obj.Spec.Devices = []kosmosv1alpha1.Device{{Name: "eth0"}}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("RetryOnConflictWithCachedState: %v", err)
+	}
+
+	got := &kosmosv1alpha1.NodeConfig{}
+	if err := c.Get(context.Background(), key, got); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if len(got.Spec.Devices) != 1 || got.Spec.Devices[0].Name != "eth0" {
+		t.Errorf("Spec.Devices = %+v, want one device named eth0", got.Spec.Devices)
+	}
+}
+
+func TestRetryStatusOnConflictWithCachedState_PatchesStatusSubresource(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := kosmosv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+
+	nc := newNodeConfig("node1")
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&kosmosv1alpha1.NodeConfig{}).
+		WithObjects(nc).
+		Build()
+	key := types.NamespacedName{Name: nc.Name}
+
+	// A status mutation sent through the main-resource patch path must not
+	// persist for a type with a status subresource — this is the regression
+	// the helper exists to prevent.
+	if err := RetryOnConflictWithCachedState(context.Background(), c, key, nc.DeepCopy(), func(obj *kosmosv1alpha1.NodeConfig) error {
+		obj.Status.LastChangeTime = &now
+		return nil
+	}); err != nil {
+		t.Fatalf("RetryOnConflictWithCachedState: %v", err)
+	}
+	got := &kosmosv1alpha1.NodeConfig{}
+	if err := c.Get(context.Background(), key, got); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Status.LastChangeTime != nil {
+		t.Fatalf("Status.LastChangeTime = %v, want nil (status sent through the main-resource patch must be dropped)", got.Status.LastChangeTime)
+	}
+
+	// The status-subresource variant must persist it.
+	if err := RetryStatusOnConflictWithCachedState(context.Background(), c, key, got.DeepCopy(), func(obj *kosmosv1alpha1.NodeConfig) error {
+		obj.Status.LastChangeTime = &now
+		return nil
+	}); err != nil {
+		t.Fatalf("RetryStatusOnConflictWithCachedState: %v", err)
+	}
+	got = &kosmosv1alpha1.NodeConfig{}
+	if err := c.Get(context.Background(), key, got); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Status.LastChangeTime == nil {
+		t.Fatalf("Status.LastChangeTime = nil, want it set via the status subresource")
+	}
+}
+
+// conflictingClient forces the first n Patch calls to fail with a conflict,
+// so tests can exercise RetryOnConflictWithCachedState's get-and-retry path.
+type conflictingClient struct {
+	client.Client
+	conflictsLeft int
+}
+
+func (c *conflictingClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if c.conflictsLeft > 0 {
+		c.conflictsLeft--
+		return apierrors.NewConflict(schema.GroupResource{Group: "kosmos.io", Resource: "nodeconfigs"}, obj.GetName(), fmt.Errorf("conflict"))
+	}
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func TestRetryOnConflictWithCachedState_RetriesOnConflict(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := kosmosv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+
+	nc := newNodeConfig("node1")
+	base := fake.NewClientBuilder().WithScheme(scheme).WithObjects(nc).Build()
+	c := &conflictingClient{Client: base, conflictsLeft: 1}
+	key := types.NamespacedName{Name: nc.Name}
+
+	attempts := 0
+	err := RetryOnConflictWithCachedState(context.Background(), c, key, nc.DeepCopy(), func(obj *kosmosv1alpha1.NodeConfig) error {
+		attempts++
+		obj.Spec.Devices = []kosmosv1alpha1.Device{{Name: "eth0"}}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("RetryOnConflictWithCachedState: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("mutate called %d times, want 2 (one conflict, one retry)", attempts)
+	}
+
+	got := &kosmosv1alpha1.NodeConfig{}
+	if err := base.Get(context.Background(), key, got); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if len(got.Spec.Devices) != 1 {
+		t.Errorf("Spec.Devices = %+v, want one device", got.Spec.Devices)
+	}
+}
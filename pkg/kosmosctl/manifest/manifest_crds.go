@@ -171,6 +171,7 @@ spec:
                 enum:
                 - p2p
                 - gateway
+                - mesh
                 type: string
               nicNodeNames:
                 items:
@@ -352,4 +353,133 @@ spec:
     subresources:
       status: {}
 `
+
+	ClusterlinkServiceExport = `---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    controller-gen.kubebuilder.io/version: v0.11.0
+  creationTimestamp: null
+  name: serviceexports.kosmos.io
+spec:
+  group: kosmos.io
+  names:
+    kind: ServiceExport
+    listKind: ServiceExportList
+    plural: serviceexports
+    singular: serviceexport
+  scope: Namespaced
+  versions:
+  - additionalPrinterColumns:
+    - jsonPath: .status.clusters
+      name: CLUSTERS
+      type: string
+    name: v1alpha1
+    schema:
+      openAPIV3Schema:
+        description: ServiceExport marks a Service for export to peer clusters joined
+          by clusterlink, mirroring the multicluster.x-k8s.io ServiceExport API.
+        properties:
+          apiVersion:
+            type: string
+          kind:
+            type: string
+          metadata:
+            type: object
+          spec:
+            type: object
+          status:
+            properties:
+              clusters:
+                items:
+                  type: string
+                type: array
+              conditions:
+                items:
+                  type: object
+                  x-kubernetes-preserve-unknown-fields: true
+                type: array
+            type: object
+        type: object
+    served: true
+    storage: true
+    subresources:
+      status: {}
+`
+
+	ClusterlinkServiceImport = `---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    controller-gen.kubebuilder.io/version: v0.11.0
+  creationTimestamp: null
+  name: serviceimports.kosmos.io
+spec:
+  group: kosmos.io
+  names:
+    kind: ServiceImport
+    listKind: ServiceImportList
+    plural: serviceimports
+    singular: serviceimport
+  scope: Namespaced
+  versions:
+  - additionalPrinterColumns:
+    - jsonPath: .spec.type
+      name: TYPE
+      type: string
+    - jsonPath: .spec.ip
+      name: IP
+      type: string
+    name: v1alpha1
+    schema:
+      openAPIV3Schema:
+        description: ServiceImport is the synthetic ClusterIP Service created on
+          a consuming cluster for a Service exported by a peer cluster.
+        properties:
+          apiVersion:
+            type: string
+          kind:
+            type: string
+          metadata:
+            type: object
+          spec:
+            properties:
+              ip:
+                type: string
+              ports:
+                items:
+                  properties:
+                    name:
+                      type: string
+                    port:
+                      format: int32
+                      type: integer
+                    protocol:
+                      type: string
+                  required:
+                  - port
+                  type: object
+                type: array
+              sourceCluster:
+                type: string
+              type:
+                default: ClusterSetIP
+                enum:
+                - ClusterSetIP
+                - Headless
+                type: string
+            required:
+            - sourceCluster
+            type: object
+          status:
+            type: object
+        required:
+        - spec
+        type: object
+    served: true
+    storage: true
+    subresources: {}
+`
 )
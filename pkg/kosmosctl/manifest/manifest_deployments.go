@@ -73,6 +73,7 @@ spec:
         command:
           - clusterlink-operator
           - --controlpanelconfig=/etc/clusterlink/kubeconfig
+          {{ if .EnableMonitoring }}- --enable-monitoring{{ end }}
         resources:
           limits:
             memory: 200Mi
@@ -106,6 +107,7 @@ type DeploymentReplace struct {
 }
 
 type ClusterlinkDeploymentReplace struct {
-	Version     string
-	ClusterName string
+	Version          string
+	ClusterName      string
+	EnableMonitoring bool
 }
@@ -0,0 +1,97 @@
+package manifest
+
+import (
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/discovery"
+)
+
+const (
+	ClusterlinkServiceMonitor = `
+apiVersion: monitoring.coreos.com/v1
+kind: ServiceMonitor
+metadata:
+  name: clusterlink
+  namespace: {{ .Namespace }}
+  labels:
+    app: clusterlink
+spec:
+  selector:
+    matchExpressions:
+      - key: app
+        operator: In
+        values:
+          - clusterlink-network-manager
+          - operator
+  namespaceSelector:
+    matchNames:
+      - {{ .Namespace }}
+  endpoints:
+    - port: metrics
+      interval: 30s
+`
+
+	ClusterlinkPrometheusRule = `
+apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: clusterlink
+  namespace: {{ .Namespace }}
+  labels:
+    app: clusterlink
+spec:
+  groups:
+    - name: clusterlink.rules
+      rules:
+        - alert: ClusterlinkTunnelDown
+          expr: clusterlink_tunnel_up == 0
+          for: 5m
+          labels:
+            severity: critical
+          annotations:
+            summary: Clusterlink tunnel {{ "{{ $labels.tunnel }}" }} has been down for more than 5 minutes.
+        - alert: ClusterlinkNodeConfigSyncLag
+          expr: time() - clusterlink_nodeconfig_last_sync_time_seconds > 300
+          for: 5m
+          labels:
+            severity: warning
+          annotations:
+            summary: NodeConfig {{ "{{ $labels.name }}" }} has not synced status.lastSyncTime in over 5 minutes.
+        - alert: ClusterlinkManagerRestartingTooOften
+          expr: increase(kube_pod_container_status_restarts_total{container=~"manager|operator"}[15m]) > 3
+          for: 0m
+          labels:
+            severity: warning
+          annotations:
+            summary: Pod {{ "{{ $labels.pod }}" }} is restarting frequently.
+`
+)
+
+// MonitoringReplace holds the template values for ClusterlinkServiceMonitor
+// and ClusterlinkPrometheusRule. Rendering and applying these (gated behind
+// --enable-monitoring and ServiceMonitorCRDInstalled) belongs to the
+// kosmosctl install command, which isn't part of this tree yet — until
+// that lands, these are manifest templates only, same as the CRDs and
+// Deployments above.
+type MonitoringReplace struct {
+	Namespace string
+}
+
+// ServiceMonitorCRDInstalled reports whether the monitoring.coreos.com/v1
+// ServiceMonitor CRD is registered on the cluster, so callers gated by
+// --enable-monitoring can skip creating ServiceMonitor/PrometheusRule
+// objects instead of failing when prometheus-operator isn't installed.
+func ServiceMonitorCRDInstalled(disco discovery.DiscoveryInterface) (bool, error) {
+	resources, err := disco.ServerResourcesForGroupVersion("monitoring.coreos.com/v1")
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	for _, r := range resources.APIResources {
+		if r.Kind == "ServiceMonitor" {
+			return true, nil
+		}
+	}
+	return false, nil
+}